@@ -17,170 +17,373 @@ limitations under the License.
 package resources
 
 import (
+	"context"
+	stderrors "errors"
 	"regexp"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/route53"
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/aws/smithy-go"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/wait"
 )
 
 // Route53
 
 type Route53ResourceRecordSets struct{}
 
-// zoneIsManaged checks if the zone should be managed (and thus have records deleted) by us
-func zoneIsManaged(z *route53.HostedZone) bool {
-	// TODO: Move to a tag on the zone?
-	name := aws.StringValue(z.Name)
-	if "test-cncf-aws.k8s.io." == name {
-		return true
+const (
+	// defaultOwnerTagKey/defaultOwnerTagValue mark a hosted zone as safe for boskos to manage
+	// when the caller hasn't configured a different tag via Options.
+	defaultOwnerTagKey   = "boskos-owned"
+	defaultOwnerTagValue = "true"
+)
+
+// route53Context returns the context to use for Route53 calls, falling back to a background
+// context so janitor runs that don't set Options.Context keep working.
+func route53Context(opts Options) context.Context {
+	if opts.Context != nil {
+		return opts.Context
 	}
+	return context.Background()
+}
 
-	logrus.Infof("unknown zone %q; ignoring", name)
-	return false
+// ownerTag returns the tag key/value a hosted zone must carry to be considered managed,
+// falling back to the boskos-owned=true default.
+func ownerTag(opts Options) (string, string) {
+	key := opts.Route53OwnerTagKey
+	if key == "" {
+		key = defaultOwnerTagKey
+	}
+	value := opts.Route53OwnerTagValue
+	if value == "" {
+		value = defaultOwnerTagValue
+	}
+	return key, value
 }
 
-var managedNameRegexes = []*regexp.Regexp{
-	// e.g. api.e2e-71149fffac-dba53.test-cncf-aws.k8s.io.
-	regexp.MustCompile(`^api\.e2e-[0-9a-z]{1,10}-[0-9a-f]{5}\.`),
+// zoneIsManaged checks if the zone carries the configured owner tag, and is thus safe for us
+// to manage (list and delete records in).
+func zoneIsManaged(ctx context.Context, svc *route53.Client, z types.HostedZone, opts Options) (bool, error) {
+	key, value := ownerTag(opts)
 
-	// e.g. api.internal.e2e-71149fffac-dba53.test-cncf-aws.k8s.io.
-	regexp.MustCompile(`^api\.internal\.e2e-[0-9a-z]{1,10}-[0-9a-f]{5}\.`),
+	resp, err := svc.ListTagsForResource(ctx, &route53.ListTagsForResourceInput{
+		ResourceType: types.TagResourceTypeHostedzone,
+		ResourceId:   z.Id,
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "couldn't list tags for hosted zone %q", awsv2.ToString(z.Id))
+	}
 
-	// e.g. etcd-b.internal.e2e-71149fffac-dba53.test-cncf-aws.k8s.io.
-	regexp.MustCompile(`^etcd-[a-z]\.internal\.e2e-[0-9a-z]{1,10}-[0-9a-f]{5}\.`),
+	for _, tag := range resp.ResourceTagSet.Tags {
+		if awsv2.ToString(tag.Key) == key && awsv2.ToString(tag.Value) == value {
+			return true, nil
+		}
+	}
 
-	// e.g. etcd-events-b.internal.e2e-71149fffac-dba53.test-cncf-aws.k8s.io.
-	regexp.MustCompile(`^etcd-events-[a-z]\.internal\.e2e-[0-9a-z]{1,10}-[0-9a-f]{5}\.`),
+	logrus.Infof("zone %q missing owner tag %s=%s; ignoring", awsv2.ToString(z.Name), key, value)
+	return false, nil
 }
 
-// resourceRecordSetIsManaged checks if the resource record should be managed (and thus deleted) by us
-func resourceRecordSetIsManaged(rrs *route53.ResourceRecordSet) bool {
-	if "A" != aws.StringValue(rrs.Type) {
-		return false
-	}
+// RecordSetPredicate decides whether a ResourceRecordSet within an already-managed zone
+// should be swept. Operators can plug in their own via Options.Route53RecordSetPredicate
+// instead of forking the module.
+type RecordSetPredicate func(types.ResourceRecordSet) bool
 
-	name := aws.StringValue(rrs.Name)
+// defaultManagedRecordTypes are the record types we sweep when no predicate is configured.
+var defaultManagedRecordTypes = map[types.RRType]bool{
+	types.RRTypeA:     true,
+	types.RRTypeAaaa:  true,
+	types.RRTypeCname: true,
+}
+
+// defaultRecordSetPredicate manages any A/AAAA/CNAME record once its zone is managed.
+func defaultRecordSetPredicate(rrs types.ResourceRecordSet) bool {
+	return defaultManagedRecordTypes[rrs.Type]
+}
 
-	for _, managedNameRegex := range managedNameRegexes {
-		if managedNameRegex.MatchString(name) {
-			return true
+// NewRegexRecordSetPredicate builds a RecordSetPredicate that matches A/AAAA/CNAME records
+// whose name matches one of nameRegexes, for operators who want to keep name-based filtering
+// (e.g. the historic k8s e2e naming scheme) without hardcoding it in the module.
+func NewRegexRecordSetPredicate(nameRegexes []*regexp.Regexp) RecordSetPredicate {
+	return func(rrs types.ResourceRecordSet) bool {
+		if !defaultManagedRecordTypes[rrs.Type] {
+			return false
 		}
+		name := awsv2.ToString(rrs.Name)
+		for _, re := range nameRegexes {
+			if re.MatchString(name) {
+				return true
+			}
+		}
+		return false
 	}
+}
 
-	logrus.Infof("Ignoring unmanaged name %q", name)
-	return false
+// resourceRecordSetIsManaged checks if the resource record should be managed (and thus deleted) by us
+func resourceRecordSetIsManaged(opts Options, rrs types.ResourceRecordSet) bool {
+	predicate := opts.Route53RecordSetPredicate
+	if predicate == nil {
+		predicate = defaultRecordSetPredicate
+	}
+
+	if !predicate(rrs) {
+		logrus.Infof("Ignoring unmanaged name %q", awsv2.ToString(rrs.Name))
+		return false
+	}
+	return true
 }
 
 // route53ResourceRecordSetsForZone marks all ResourceRecordSets in the provided zone and returns a slice containing those that should be deleted.
-func route53ResourceRecordSetsForZone(logger logrus.FieldLogger, svc *route53.Route53, zone *route53.HostedZone, set *Set) ([]*route53ResourceRecordSet, error) {
+func route53ResourceRecordSetsForZone(ctx context.Context, logger logrus.FieldLogger, svc *route53.Client, zone types.HostedZone, opts Options, set *Set) ([]*route53ResourceRecordSet, error) {
 	var toDelete []*route53ResourceRecordSet
 
-	recordsPageFunc := func(records *route53.ListResourceRecordSetsOutput, _ bool) bool {
-		for _, rrs := range records.ResourceRecordSets {
-			if !resourceRecordSetIsManaged(rrs) {
+	paginator := route53.NewListResourceRecordSetsPaginator(svc, &route53.ListResourceRecordSetsInput{HostedZoneId: zone.Id})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, rrs := range page.ResourceRecordSets {
+			if !resourceRecordSetIsManaged(opts, rrs) {
 				continue
 			}
 
 			o := &route53ResourceRecordSet{zone: zone, obj: rrs}
 			if set.Mark(o, nil) {
-				logger.Warningf("%s: deleting %T: %s", o.ARN(), rrs, *rrs.Name)
+				logger.Warningf("%s: deleting %T: %s", o.ARN(), rrs, awsv2.ToString(rrs.Name))
+				toDelete = append(toDelete, o)
 			}
 		}
-		return true
 	}
 
-	err := svc.ListResourceRecordSetsPages(&route53.ListResourceRecordSetsInput{HostedZoneId: zone.Id}, recordsPageFunc)
+	return toDelete, nil
+}
+
+const (
+	// maxChangesPerBatch is Route53's documented limit on the number of changes in a
+	// single ChangeResourceRecordSets call.
+	maxChangesPerBatch = 1000
+
+	// maxChangeBatchRDATABytes is Route53's documented limit on the total "RDATA size" of a
+	// single ChangeResourceRecordSets call: the sum, over every change, of the record's Name
+	// length plus the length of each of its ResourceRecord Values (doubled for UPSERTs).
+	maxChangeBatchRDATABytes = 32000
+)
+
+// changeRDATASize computes the RDATA size Route53 charges a Change against the 32000 byte
+// per-batch limit: len(Name) plus len(Value) for every ResourceRecord, doubled for UPSERTs
+// because they count against both the old and new record.
+func changeRDATASize(c types.Change) int {
+	size := len(awsv2.ToString(c.ResourceRecordSet.Name))
+	for _, rr := range c.ResourceRecordSet.ResourceRecords {
+		size += len(awsv2.ToString(rr.Value))
+	}
+	if c.Action == types.ChangeActionUpsert {
+		size *= 2
+	}
+	return size
+}
+
+// changeBatcher groups Changes into ChangeBatch-sized chunks that respect both the
+// 1000-change and 32000-byte RDATA limits Route53 enforces per ChangeResourceRecordSets call.
+type changeBatcher struct {
+	batches     [][]types.Change
+	current     []types.Change
+	currentSize int
+}
+
+// Add appends c to the batcher, starting a new batch first if c would overflow the current one.
+func (b *changeBatcher) Add(c types.Change) {
+	size := changeRDATASize(c)
+	if len(b.current) > 0 && (len(b.current)+1 > maxChangesPerBatch || b.currentSize+size > maxChangeBatchRDATABytes) {
+		b.flush()
+	}
+	b.current = append(b.current, c)
+	b.currentSize += size
+}
+
+func (b *changeBatcher) flush() {
+	if len(b.current) == 0 {
+		return
+	}
+	b.batches = append(b.batches, b.current)
+	b.current = nil
+	b.currentSize = 0
+}
+
+// Batches returns the accumulated Changes grouped into Route53-limit-respecting batches.
+func (b *changeBatcher) Batches() [][]types.Change {
+	b.flush()
+	return b.batches
+}
+
+// route53RetryableErrorCodes are the AWS error codes Route53 returns when its very low
+// mutation-rate limits are exceeded; these are worth retrying with backoff rather than
+// failing the sweep outright.
+var route53RetryableErrorCodes = map[string]bool{
+	"Throttling":              true,
+	"PriorRequestNotComplete": true,
+}
+
+// changeResourceRecordSetsWithRetry calls ChangeResourceRecordSets, retrying with exponential
+// backoff and jitter when Route53 reports it's being throttled.
+func changeResourceRecordSetsWithRetry(ctx context.Context, svc *route53.Client, input *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error) {
+	var out *route53.ChangeResourceRecordSetsOutput
+	backoff := wait.Backoff{Duration: time.Second, Factor: 2, Jitter: 0.3, Steps: 6}
+	err := wait.ExponentialBackoff(backoff, func() (bool, error) {
+		var callErr error
+		out, callErr = svc.ChangeResourceRecordSets(ctx, input)
+		if callErr == nil {
+			return true, nil
+		}
+		var apiErr smithy.APIError
+		if stderrors.As(callErr, &apiErr) && route53RetryableErrorCodes[apiErr.ErrorCode()] {
+			return false, nil
+		}
+		return false, callErr
+	})
+	return out, err
+}
+
+// defaultRoute53PropagationTimeout bounds how long we wait for a zone's deletes to reach
+// INSYNC when Options.Route53PropagationTimeout isn't set.
+const defaultRoute53PropagationTimeout = 15 * time.Minute
+
+// waitForChangeSync polls GetChange until changeID's status is INSYNC, or returns an error
+// once timeout has elapsed. An empty changeID (nothing was submitted) is a no-op.
+func waitForChangeSync(ctx context.Context, svc *route53.Client, changeID string, timeout time.Duration) error {
+	if changeID == "" {
+		return nil
+	}
+	if timeout <= 0 {
+		timeout = defaultRoute53PropagationTimeout
+	}
+
+	err := wait.PollImmediate(15*time.Second, timeout, func() (bool, error) {
+		resp, err := svc.GetChange(ctx, &route53.GetChangeInput{Id: awsv2.String(changeID)})
+		if err != nil {
+			return false, err
+		}
+		return resp.ChangeInfo.Status == types.ChangeStatusInsync, nil
+	})
 	if err != nil {
-		return nil, err
+		return errors.Wrapf(err, "change %q did not reach INSYNC within %s", changeID, timeout)
 	}
-	return toDelete, nil
+	return nil
 }
 
 func (Route53ResourceRecordSets) MarkAndSweep(opts Options, set *Set) error {
 	logger := logrus.WithField("options", opts)
-	svc := route53.New(opts.Session, aws.NewConfig().WithRegion(opts.Region))
+	ctx := route53Context(opts)
+	svc := route53.NewFromConfig(opts.Config, func(o *route53.Options) { o.Region = opts.Region })
 
 	var listError error
 
-	pageFunc := func(zones *route53.ListHostedZonesOutput, _ bool) bool {
+	paginator := route53.NewListHostedZonesPaginator(svc, &route53.ListHostedZonesInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			listError = err
+			break
+		}
+
 		// Because route53 has such low rate limits, we collect the changes per-zone, to minimize API calls
-		for _, z := range zones.HostedZones {
-			if !zoneIsManaged(z) {
+		for _, z := range page.HostedZones {
+			managed, err := zoneIsManaged(ctx, svc, z, opts)
+			if err != nil {
+				listError = err
+				break
+			}
+			if !managed {
 				continue
 			}
 
-			toDelete, err := route53ResourceRecordSetsForZone(logger, svc, z, set)
+			toDelete, err := route53ResourceRecordSetsForZone(ctx, logger, svc, z, opts, set)
 			if err != nil {
 				listError = err
-				return false
+				break
 			}
 			if opts.DryRun {
 				continue
 			}
 
-			var changes []*route53.Change
+			var batcher changeBatcher
 			for _, rrs := range toDelete {
-				change := &route53.Change{
-					Action:            aws.String(route53.ChangeActionDelete),
-					ResourceRecordSet: rrs.obj,
-				}
-
-				changes = append(changes, change)
+				batcher.Add(types.Change{
+					Action:            types.ChangeActionDelete,
+					ResourceRecordSet: &rrs.obj,
+				})
 			}
 
-			for len(changes) != 0 {
-				// Limit of 1000 changes per request
-				chunk := changes
-				if len(chunk) > 1000 {
-					chunk = chunk[:1000]
-					changes = changes[1000:]
-				} else {
-					changes = nil
-				}
-
+			// Submit every batch before waiting on propagation: waiting per-batch would
+			// serialize API calls behind Route53's slow change propagation, whereas a
+			// single wait on the last change confirms the whole sweep took effect.
+			var lastChangeID string
+			for _, chunk := range batcher.Batches() {
 				logger.Infof("Deleting %d route53 resource records", len(chunk))
 				deleteReq := &route53.ChangeResourceRecordSetsInput{
 					HostedZoneId: z.Id,
-					ChangeBatch:  &route53.ChangeBatch{Changes: chunk},
+					ChangeBatch:  &types.ChangeBatch{Changes: chunk},
 				}
 
-				if _, err := svc.ChangeResourceRecordSets(deleteReq); err != nil {
-					logger.Warningf("unable to delete DNS records: %v", err)
+				out, err := changeResourceRecordSetsWithRetry(ctx, svc, deleteReq)
+				if err != nil {
+					listError = errors.Wrapf(err, "unable to delete DNS records in zone %q", awsv2.ToString(z.Id))
+					break
 				}
+				lastChangeID = awsv2.ToString(out.ChangeInfo.Id)
+			}
+			if listError != nil {
+				break
 			}
-		}
-
-		return true
-	}
-
-	err := svc.ListHostedZonesPages(&route53.ListHostedZonesInput{}, pageFunc)
 
-	if listError != nil {
-		return listError
-	}
+			if err := waitForChangeSync(ctx, svc, lastChangeID, opts.Route53PropagationTimeout); err != nil {
+				listError = errors.Wrapf(err, "zone %q", awsv2.ToString(z.Id))
+				break
+			}
+		}
 
-	if err != nil {
-		return err
+		if listError != nil {
+			break
+		}
 	}
 
-	return nil
+	return listError
 }
 
 func (Route53ResourceRecordSets) ListAll(opts Options) (*Set, error) {
-	svc := route53.New(opts.Session, aws.NewConfig().WithRegion(opts.Region))
+	ctx := route53Context(opts)
+	svc := route53.NewFromConfig(opts.Config, func(o *route53.Options) { o.Region = opts.Region })
 	set := NewSet(0)
 
-	var rrsErr error
-	err := svc.ListHostedZonesPages(&route53.ListHostedZonesInput{}, func(zones *route53.ListHostedZonesOutput, _ bool) bool {
+	zonesPaginator := route53.NewListHostedZonesPaginator(svc, &route53.ListHostedZonesInput{})
+	for zonesPaginator.HasMorePages() {
+		zones, err := zonesPaginator.NextPage(ctx)
+		if err != nil {
+			return set, errors.Wrapf(err, "couldn't describe route53 hosted zones for %q in %q", opts.Account, opts.Region)
+		}
+
 		for _, z := range zones.HostedZones {
-			if !zoneIsManaged(z) {
+			managed, err := zoneIsManaged(ctx, svc, z, opts)
+			if err != nil {
+				return set, err
+			}
+			if !managed {
 				continue
 			}
-			inp := &route53.ListResourceRecordSetsInput{HostedZoneId: z.Id}
-			err := svc.ListResourceRecordSetsPages(inp, func(recordSets *route53.ListResourceRecordSetsOutput, _ bool) bool {
+
+			recordsPaginator := route53.NewListResourceRecordSetsPaginator(svc, &route53.ListResourceRecordSetsInput{HostedZoneId: z.Id})
+			for recordsPaginator.HasMorePages() {
+				recordSets, err := recordsPaginator.NextPage(ctx)
+				if err != nil {
+					return set, errors.Wrapf(err, "couldn't describe route53 resources for %q in %q zone %q", opts.Account, opts.Region, awsv2.ToString(z.Id))
+				}
+
 				now := time.Now()
 				for _, recordSet := range recordSets.ResourceRecordSets {
 					arn := route53ResourceRecordSet{
@@ -189,33 +392,169 @@ func (Route53ResourceRecordSets) ListAll(opts Options) (*Set, error) {
 					}.ARN()
 					set.firstSeen[arn] = now
 				}
-				return true
-			})
-			if err != nil {
-				rrsErr = errors.Wrapf(err, "couldn't describe route53 resources for %q in %q zone %q", opts.Account, opts.Region, *z.Id)
-				return false
 			}
-
 		}
-		return true
-	})
-
-	if rrsErr != nil {
-		return set, rrsErr
 	}
-	return set, errors.Wrapf(err, "couldn't describe route53 instance profiles for %q in %q", opts.Account, opts.Region)
 
+	return set, nil
 }
 
 type route53ResourceRecordSet struct {
-	zone *route53.HostedZone
-	obj  *route53.ResourceRecordSet
+	zone types.HostedZone
+	obj  types.ResourceRecordSet
 }
 
 func (r route53ResourceRecordSet) ARN() string {
-	return "route53::" + aws.StringValue(r.zone.Id) + "::" + aws.StringValue(r.obj.Type) + "::" + aws.StringValue(r.obj.Name)
+	return "route53::" + awsv2.ToString(r.zone.Id) + "::" + string(r.obj.Type) + "::" + awsv2.ToString(r.obj.Name)
 }
 
 func (r route53ResourceRecordSet) ResourceKey() string {
 	return r.ARN()
 }
+
+// Route53HostedZones sweeps entire managed hosted zones, force-destroying any leftover
+// records so the zone itself can be deleted. This reclaims per-test ephemeral zones that
+// Route53ResourceRecordSets would otherwise leave behind empty (just their apex NS/SOA).
+type Route53HostedZones struct{}
+
+// apexRecordTypes are the two record types every hosted zone is born with and that Route53
+// refuses to delete; force-destroy must leave them in place for DeleteHostedZone to succeed.
+var apexRecordTypes = map[types.RRType]bool{
+	types.RRTypeNs:  true,
+	types.RRTypeSoa: true,
+}
+
+// forceDestroyZoneRecords deletes every ResourceRecordSet in zone except the mandatory apex
+// NS/SOA records, batching deletes with the same count/size-aware batcher record sweeping
+// uses, and waits for the last batch to reach INSYNC before returning.
+func forceDestroyZoneRecords(ctx context.Context, logger logrus.FieldLogger, svc *route53.Client, zone types.HostedZone, opts Options) error {
+	var batcher changeBatcher
+
+	paginator := route53.NewListResourceRecordSetsPaginator(svc, &route53.ListResourceRecordSetsInput{HostedZoneId: zone.Id})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, rrs := range page.ResourceRecordSets {
+			if apexRecordTypes[rrs.Type] && awsv2.ToString(rrs.Name) == awsv2.ToString(zone.Name) {
+				continue
+			}
+			rrs := rrs
+			batcher.Add(types.Change{
+				Action:            types.ChangeActionDelete,
+				ResourceRecordSet: &rrs,
+			})
+		}
+	}
+
+	var lastChangeID string
+	for _, chunk := range batcher.Batches() {
+		logger.Infof("Deleting %d route53 resource records to force-destroy zone %q", len(chunk), awsv2.ToString(zone.Id))
+		out, err := changeResourceRecordSetsWithRetry(ctx, svc, &route53.ChangeResourceRecordSetsInput{
+			HostedZoneId: zone.Id,
+			ChangeBatch:  &types.ChangeBatch{Changes: chunk},
+		})
+		if err != nil {
+			return errors.Wrapf(err, "unable to force-destroy records in zone %q", awsv2.ToString(zone.Id))
+		}
+		lastChangeID = awsv2.ToString(out.ChangeInfo.Id)
+	}
+
+	return waitForChangeSync(ctx, svc, lastChangeID, opts.Route53PropagationTimeout)
+}
+
+func (Route53HostedZones) MarkAndSweep(opts Options, set *Set) error {
+	logger := logrus.WithField("options", opts)
+	ctx := route53Context(opts)
+	svc := route53.NewFromConfig(opts.Config, func(o *route53.Options) { o.Region = opts.Region })
+
+	var listError error
+
+	paginator := route53.NewListHostedZonesPaginator(svc, &route53.ListHostedZonesInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			listError = err
+			break
+		}
+
+		for _, z := range page.HostedZones {
+			managed, err := zoneIsManaged(ctx, svc, z, opts)
+			if err != nil {
+				listError = err
+				break
+			}
+			if !managed {
+				continue
+			}
+
+			o := &route53HostedZone{obj: z}
+			if !set.Mark(o, nil) {
+				continue
+			}
+			logger.Warningf("%s: deleting %T: %s", o.ARN(), z, awsv2.ToString(z.Name))
+
+			if opts.DryRun {
+				continue
+			}
+
+			if err := forceDestroyZoneRecords(ctx, logger, svc, z, opts); err != nil {
+				listError = errors.Wrapf(err, "couldn't force-destroy records in zone %q", awsv2.ToString(z.Id))
+				break
+			}
+
+			if _, err := svc.DeleteHostedZone(ctx, &route53.DeleteHostedZoneInput{Id: z.Id}); err != nil {
+				listError = errors.Wrapf(err, "couldn't delete hosted zone %q", awsv2.ToString(z.Id))
+				break
+			}
+		}
+
+		if listError != nil {
+			break
+		}
+	}
+
+	return listError
+}
+
+func (Route53HostedZones) ListAll(opts Options) (*Set, error) {
+	ctx := route53Context(opts)
+	svc := route53.NewFromConfig(opts.Config, func(o *route53.Options) { o.Region = opts.Region })
+	set := NewSet(0)
+
+	paginator := route53.NewListHostedZonesPaginator(svc, &route53.ListHostedZonesInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return set, errors.Wrapf(err, "couldn't describe route53 hosted zones for %q in %q", opts.Account, opts.Region)
+		}
+
+		now := time.Now()
+		for _, z := range page.HostedZones {
+			managed, err := zoneIsManaged(ctx, svc, z, opts)
+			if err != nil {
+				return set, err
+			}
+			if !managed {
+				continue
+			}
+			set.firstSeen[route53HostedZone{obj: z}.ARN()] = now
+		}
+	}
+
+	return set, nil
+}
+
+type route53HostedZone struct {
+	obj types.HostedZone
+}
+
+func (z route53HostedZone) ARN() string {
+	return "route53-zone::" + awsv2.ToString(z.obj.Id)
+}
+
+func (z route53HostedZone) ResourceKey() string {
+	return z.ARN()
+}